@@ -0,0 +1,53 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bountybounter/RestRPC/pkg/httpauth"
+)
+
+func splitRoles(roles string) []string {
+	if roles == "" {
+		return nil
+	}
+	parts := strings.Split(roles, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return parts
+}
+
+// newAuthenticator builds an httpauth.Authenticator from the current
+// config, or nil if no routes are protected. It is a snapshot: a SIGHUP
+// reload that changes Auth/User/Route takes effect only on restart.
+func newAuthenticator() *httpauth.Authenticator {
+	cfg := configStore.Current()
+	if len(cfg.Route) == 0 {
+		return nil
+	}
+
+	ttl, err := time.ParseDuration(cfg.Auth.SessionTTL)
+	if err != nil {
+		log.Fatalf("Invalid Auth.SessionTTL %q: %s", cfg.Auth.SessionTTL, err)
+	}
+
+	users := make(map[string]httpauth.User, len(cfg.User))
+	for name, u := range cfg.User {
+		users[name] = httpauth.User{PasswordHash: u.PasswordHash, Roles: splitRoles(u.Roles)}
+	}
+
+	routes := make(map[string]httpauth.Route, len(cfg.Route))
+	for path, r := range cfg.Route {
+		routes[path] = httpauth.Route{Roles: splitRoles(r.Roles)}
+	}
+
+	return httpauth.New(httpauth.Config{
+		CookieSecret: cfg.Auth.CookieSecret,
+		CSRFSecret:   cfg.Auth.CSRFSecret,
+		SessionTTL:   ttl,
+		Users:        users,
+		Routes:       routes,
+	})
+}
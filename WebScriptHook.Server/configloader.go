@@ -1,31 +1,36 @@
 package main
 
 import (
-	"fmt"
-	"io/ioutil"
+	"flag"
 	"log"
+	"os"
 
-	"gopkg.in/gcfg.v1"
+	"github.com/bountybounter/RestRPC/pkg/config"
 )
 
-var serverConfig = struct {
-	Server struct {
-		Port string
+var configStore *config.Store
+
+// readConfig locates the config file (via -config, RESTRPC_CONFIG, or the
+// historical default path), picks a format (via -config-format or the
+// file's extension), and loads it into configStore.
+func readConfig() {
+	configPath := flag.String("config", "", "path to the config file (default webscripthook.server.ini, or $RESTRPC_CONFIG)")
+	configFormat := flag.String("config-format", "", "config format: ini, yaml, or json (default: guessed from the file extension)")
+	flag.Parse()
+
+	path := *configPath
+	if path == "" {
+		path = os.Getenv("RESTRPC_CONFIG")
 	}
-	TLS struct {
-		UseHTTPS bool
-		Cert     string
-		Key      string
+	if path == "" {
+		path = "webscripthook.server.ini"
 	}
-}{}
 
-func readConfig() {
-	log.Println("Reading config")
-	filebytes, _ := ioutil.ReadFile("webscripthook.server.ini")
-	cfgStr := string(filebytes)
-	fmt.Println(cfgStr)
-	err := gcfg.ReadStringInto(&serverConfig, cfgStr)
+	log.Printf("Reading config from %s", path)
+	store, err := config.NewStore(path, *configFormat)
 	if err != nil {
-		log.Fatalf("Failed to parse gcfg data: %s", err)
+		log.Fatalf("Failed to load config: %s", err)
 	}
+
+	configStore = store
 }
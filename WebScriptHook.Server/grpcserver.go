@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/bountybounter/RestRPC/pkg/rpc"
+)
+
+// scriptHookServer implements rpc.ScriptHookServer on top of the same
+// runScript function the REST handler uses.
+type scriptHookServer struct {
+	rpc.UnimplementedScriptHookServer
+}
+
+func (scriptHookServer) Invoke(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+	resp, err := runScript(ScriptRequest{Name: req.GetName(), Args: req.GetArgs()})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ScriptResponse{Output: resp.Output, ExitCode: int32(resp.ExitCode)}, nil
+}
+
+func (scriptHookServer) InvokeStream(req *rpc.ScriptRequest, stream rpc.ScriptHook_InvokeStreamServer) error {
+	resp, err := runScript(ScriptRequest{Name: req.GetName(), Args: req.GetArgs()})
+	if err != nil {
+		return err
+	}
+	return stream.Send(&rpc.ScriptResponse{Output: resp.Output, ExitCode: int32(resp.ExitCode)})
+}
+
+// newGRPCServer builds a *grpc.Server for the ScriptHook service, reusing
+// the HTTP listener's TLS certificate (looked up via currentCertificate on
+// every handshake, so a SIGHUP rotation applies here too) when
+// GRPC.UseTLS is set.
+func newGRPCServer() *grpc.Server {
+	var opts []grpc.ServerOption
+	if configStore.Current().GRPC.UseTLS {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{GetCertificate: currentCertificate})))
+	}
+
+	s := grpc.NewServer(opts...)
+	rpc.RegisterScriptHookServer(s, scriptHookServer{})
+	return s
+}
+
+// serveGRPC starts the gRPC listener and blocks until it stops serving.
+func serveGRPC(s *grpc.Server) {
+	port := configStore.Current().GRPC.Port
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatalf("Failed to listen on gRPC port %s: %s", port, err)
+	}
+
+	log.Printf("gRPC ScriptHook service listening on :%s", port)
+	if err := s.Serve(lis); err != nil {
+		log.Printf("gRPC server stopped: %s", err)
+	}
+}
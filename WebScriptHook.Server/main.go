@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func main() {
+	readConfig()
+	loadPlugins()
+	watchSIGHUP()
+
+	cfg := configStore.Current()
+	authenticator := newAuthenticator()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/script", scriptHookHandler)
+	if authenticator != nil {
+		mux.HandleFunc("/login", authenticator.LoginHandler)
+		mux.HandleFunc("/logout", authenticator.LogoutHandler)
+	}
+
+	var handler http.Handler = mux
+	if authenticator != nil {
+		handler = authenticator.Require(mux)
+	}
+	httpServer := &http.Server{Addr: ":" + cfg.Server.Port, Handler: handler}
+	if cfg.TLS.UseHTTPS {
+		if _, err := currentCertificate(nil); err != nil {
+			log.Fatalf("Failed to load TLS material: %s", err)
+		}
+		httpServer.TLSConfig = &tls.Config{GetCertificate: currentCertificate}
+	}
+
+	go func() {
+		var err error
+		if cfg.TLS.UseHTTPS {
+			// Cert/key paths come from the TLSConfig.GetCertificate
+			// callback above, not these (empty) arguments, so each
+			// handshake picks up the latest rotated certificate.
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatalf("HTTP server stopped: %s", err)
+		}
+	}()
+	log.Printf("HTTP ScriptHook server listening on :%s", cfg.Server.Port)
+
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		grpcServer = newGRPCServer()
+		go serveGRPC(grpcServer)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+	log.Println("Shutting down")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Printf("HTTP graceful shutdown failed: %s", err)
+	}
+
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
+
+	pluginManager.Shutdown()
+}
+
+var certCacheMu sync.Mutex
+var certCachePaths [2]string
+var certCacheCert *tls.Certificate
+
+// currentCertificate returns the TLS certificate for the currently active
+// config's TLS.Cert/TLS.Key paths, re-reading them from disk only when
+// those paths change (e.g. after a SIGHUP-triggered rotation) rather than
+// on every handshake.
+func currentCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cfg := configStore.Current()
+
+	certCacheMu.Lock()
+	defer certCacheMu.Unlock()
+	if certCacheCert != nil && certCachePaths == [2]string{cfg.TLS.Cert, cfg.TLS.Key} {
+		return certCacheCert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TLS.Cert, cfg.TLS.Key)
+	if err != nil {
+		return nil, err
+	}
+	certCachePaths = [2]string{cfg.TLS.Cert, cfg.TLS.Key}
+	certCacheCert = &cert
+	return certCacheCert, nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// watchSIGHUP reloads configStore whenever the process receives SIGHUP,
+// logging what changed on success or why the reload was rejected on
+// failure. The previous config stays active on failure.
+func watchSIGHUP() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			changes, err := configStore.Reload()
+			if err != nil {
+				log.Printf("Config reload failed, keeping previous config: %s", err)
+				continue
+			}
+			log.Println("Config reloaded:")
+			for _, change := range changes {
+				log.Printf("  %s", change)
+			}
+		}
+	}()
+}
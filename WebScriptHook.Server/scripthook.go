@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/bountybounter/RestRPC/pkg/plugin"
+	"github.com/bountybounter/RestRPC/pkg/rpc"
+)
+
+// ScriptRequest is the input to a script invocation, shared by the REST
+// and gRPC surfaces.
+type ScriptRequest struct {
+	Name string            `json:"name"`
+	Args map[string]string `json:"args"`
+}
+
+// ScriptResponse is the result of running a script.
+type ScriptResponse struct {
+	Output   string `json:"output"`
+	ExitCode int    `json:"exitCode"`
+}
+
+// pluginManager routes script invocations to the first registered
+// executor willing to handle them, falling back to localRunScript.
+var pluginManager = plugin.NewManager()
+
+// loadPlugins launches every plugin configured under [Plugin "name"],
+// then registers the local fallback executor last, so a configured
+// plugin always gets first refusal on a script name before local's
+// catch-all CanHandle claims it.
+func loadPlugins() {
+	for name, cfg := range configStore.Current().Plugin {
+		if err := pluginManager.Launch(name, plugin.Config{
+			Path:      cfg.Path,
+			Protocol:  cfg.Protocol,
+			Handshake: cfg.Handshake,
+		}); err != nil {
+			log.Fatalf("Failed to launch plugin %q: %s", name, err)
+		}
+	}
+
+	pluginManager.Register("local", plugin.NewLocalExecutor(func(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+		resp, err := localRunScript(ScriptRequest{Name: req.GetName(), Args: req.GetArgs()})
+		if err != nil {
+			return nil, err
+		}
+		return &rpc.ScriptResponse{Output: resp.Output, ExitCode: int32(resp.ExitCode)}, nil
+	}))
+}
+
+// localRunScript is the original in-process script runner, kept as the
+// fallback executor for scripts no plugin claims.
+func localRunScript(req ScriptRequest) (ScriptResponse, error) {
+	// Placeholder execution until the real script runner is wired up.
+	return ScriptResponse{Output: "ok", ExitCode: 0}, nil
+}
+
+// runScript routes a request through the plugin manager.
+func runScript(req ScriptRequest) (ScriptResponse, error) {
+	resp, err := pluginManager.Route(context.Background(), &rpc.ScriptRequest{Name: req.Name, Args: req.Args})
+	if err != nil {
+		return ScriptResponse{}, err
+	}
+	return ScriptResponse{Output: resp.GetOutput(), ExitCode: int(resp.GetExitCode())}, nil
+}
+
+func scriptHookHandler(w http.ResponseWriter, r *http.Request) {
+	var req ScriptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := runScript(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+const iniFixture = `
+[server]
+port = 8080
+
+[tls]
+useHTTPS = true
+cert = /etc/restrpc/cert.pem
+key = /etc/restrpc/key.pem
+
+[grpc]
+enabled = true
+port = 9090
+
+[plugin "echo"]
+path = /usr/local/bin/restrpc-echo
+protocol = grpc
+`
+
+const yamlFixture = `
+server:
+  port: "8080"
+tls:
+  useHTTPS: true
+  cert: /etc/restrpc/cert.pem
+  key: /etc/restrpc/key.pem
+grpc:
+  enabled: true
+  port: "9090"
+plugin:
+  echo:
+    path: /usr/local/bin/restrpc-echo
+    protocol: grpc
+`
+
+const jsonFixture = `{
+  "server": {"port": "8080"},
+  "tls": {"useHTTPS": true, "cert": "/etc/restrpc/cert.pem", "key": "/etc/restrpc/key.pem"},
+  "grpc": {"enabled": true, "port": "9090"},
+  "plugin": {"echo": {"path": "/usr/local/bin/restrpc-echo", "protocol": "grpc"}}
+}`
+
+func TestLoadConfig_RoundTrip(t *testing.T) {
+	tests := []struct {
+		format  string
+		fixture string
+	}{
+		{"ini", iniFixture},
+		{"yaml", yamlFixture},
+		{"json", jsonFixture},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cfg, err := LoadConfig(strings.NewReader(tt.fixture), tt.format)
+			if err != nil {
+				t.Fatalf("LoadConfig(%q): %v", tt.format, err)
+			}
+			if cfg.Server.Port != "8080" {
+				t.Errorf("Server.Port = %q, want %q", cfg.Server.Port, "8080")
+			}
+			if !cfg.TLS.UseHTTPS || cfg.TLS.Cert != "/etc/restrpc/cert.pem" || cfg.TLS.Key != "/etc/restrpc/key.pem" {
+				t.Errorf("TLS = %+v, want UseHTTPS=true with cert/key set", cfg.TLS)
+			}
+			if !cfg.GRPC.Enabled || cfg.GRPC.Port != "9090" {
+				t.Errorf("GRPC = %+v, want Enabled=true Port=9090", cfg.GRPC)
+			}
+			plugin, ok := cfg.Plugin["echo"]
+			if !ok {
+				t.Fatalf("Plugin[%q] missing", "echo")
+			}
+			if plugin.Path != "/usr/local/bin/restrpc-echo" || plugin.Protocol != "grpc" {
+				t.Errorf("Plugin[%q] = %+v, want Path/Protocol set", "echo", plugin)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_UnknownFormat(t *testing.T) {
+	if _, err := LoadConfig(strings.NewReader(""), "toml"); err == nil {
+		t.Fatal("LoadConfig with unknown format: got nil error, want one")
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	tests := map[string]string{
+		"server.ini":  "ini",
+		"server.yaml": "yaml",
+		"server.yml":  "yaml",
+		"server.json": "json",
+		"server":      "ini",
+	}
+	for path, want := range tests {
+		if got := FormatFromPath(path); got != want {
+			t.Errorf("FormatFromPath(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestValidate_RouteRequiresAuthSecrets(t *testing.T) {
+	cfg := &ServerConfig{}
+	cfg.Route = map[string]*struct {
+		Roles string `yaml:"roles" json:"roles"`
+	}{
+		"/script": {Roles: "admin"},
+	}
+
+	if err := Validate(cfg); err == nil {
+		t.Fatal("Validate with a Route but no Auth secrets: got nil error, want one")
+	}
+
+	cfg.Auth.CookieSecret = "cookie-secret"
+	cfg.Auth.CSRFSecret = "csrf-secret"
+	if err := Validate(cfg); err != nil {
+		t.Fatalf("Validate with Auth secrets set: %v", err)
+	}
+}
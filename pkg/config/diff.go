@@ -0,0 +1,51 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// restartNote is appended to diff lines for fields the running process
+// only reads once at startup, so operators don't assume a SIGHUP alone
+// applied them.
+const restartNote = " (requires restart to take effect)"
+
+// Diff describes what changed between two ServerConfigs, one line per
+// changed field or set of plugin/user/route entries, for logging on
+// reload. Only TLS.Cert/TLS.Key are re-read live (via the TLS listeners'
+// GetCertificate callback); every other field is marked as needing a
+// restart, since it's read once at startup.
+func Diff(old, new *ServerConfig) []string {
+	var changes []string
+
+	if old.Server.Port != new.Server.Port {
+		changes = append(changes, fmt.Sprintf("Server.Port: %q -> %q%s", old.Server.Port, new.Server.Port, restartNote))
+	}
+	if old.TLS.UseHTTPS != new.TLS.UseHTTPS {
+		changes = append(changes, fmt.Sprintf("TLS.UseHTTPS: %v -> %v%s", old.TLS.UseHTTPS, new.TLS.UseHTTPS, restartNote))
+	}
+	if old.TLS.Cert != new.TLS.Cert || old.TLS.Key != new.TLS.Key {
+		changes = append(changes, fmt.Sprintf("TLS.Cert/TLS.Key: %q/%q -> %q/%q",
+			old.TLS.Cert, old.TLS.Key, new.TLS.Cert, new.TLS.Key))
+	}
+	if old.GRPC != new.GRPC {
+		changes = append(changes, fmt.Sprintf("GRPC: %+v -> %+v%s", old.GRPC, new.GRPC, restartNote))
+	}
+	if old.Auth != new.Auth {
+		changes = append(changes, "Auth: secrets or session TTL changed"+restartNote)
+	}
+	if !reflect.DeepEqual(old.Plugin, new.Plugin) {
+		changes = append(changes, fmt.Sprintf("Plugin: %d -> %d entries (content changed)%s", len(old.Plugin), len(new.Plugin), restartNote))
+	}
+	if !reflect.DeepEqual(old.User, new.User) {
+		changes = append(changes, fmt.Sprintf("User: %d -> %d entries (content changed)%s", len(old.User), len(new.User), restartNote))
+	}
+	if !reflect.DeepEqual(old.Route, new.Route) {
+		changes = append(changes, fmt.Sprintf("Route: %d -> %d entries (content changed)%s", len(old.Route), len(new.Route), restartNote))
+	}
+
+	if len(changes) == 0 {
+		changes = append(changes, "no effective change")
+	}
+	return changes
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff_DetectsValueChangeWithSameCardinality(t *testing.T) {
+	old, err := LoadConfig(strings.NewReader(`{"plugin": {"echo": {"path": "/bin/old", "protocol": "grpc"}}}`), "json")
+	if err != nil {
+		t.Fatalf("LoadConfig(old): %v", err)
+	}
+	next, err := LoadConfig(strings.NewReader(`{"plugin": {"echo": {"path": "/bin/new", "protocol": "grpc"}}}`), "json")
+	if err != nil {
+		t.Fatalf("LoadConfig(next): %v", err)
+	}
+
+	changes := Diff(old, next)
+	for _, c := range changes {
+		if strings.HasPrefix(c, "Plugin:") {
+			return
+		}
+	}
+	t.Errorf("Diff(old, next) = %v, want a Plugin change even though entry count is unchanged", changes)
+}
+
+func TestDiff_NoChange(t *testing.T) {
+	cfg, err := LoadConfig(strings.NewReader(`{"plugin": {"echo": {"path": "/bin/echo"}}}`), "json")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	other, err := LoadConfig(strings.NewReader(`{"plugin": {"echo": {"path": "/bin/echo"}}}`), "json")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	changes := Diff(cfg, other)
+	if len(changes) != 1 || changes[0] != "no effective change" {
+		t.Errorf("Diff(cfg, other) = %v, want [\"no effective change\"]", changes)
+	}
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+)
+
+// Store holds the currently-active ServerConfig behind an atomic pointer
+// so readers never observe a partially-applied reload.
+type Store struct {
+	path    string
+	format  string
+	current atomic.Pointer[ServerConfig]
+}
+
+// NewStore loads path (in format, or guessed from its extension if format
+// is empty) and returns a Store seeded with the result.
+func NewStore(path, format string) (*Store, error) {
+	if format == "" {
+		format = FormatFromPath(path)
+	}
+
+	cfg, err := load(path, format)
+	if err != nil {
+		return nil, err
+	}
+	if err := Validate(cfg); err != nil {
+		return nil, err
+	}
+
+	s := &Store{path: path, format: format}
+	s.current.Store(cfg)
+	return s, nil
+}
+
+// Current returns the active ServerConfig. Callers should call this on
+// every use rather than caching the result, so they pick up reloads.
+func (s *Store) Current() *ServerConfig {
+	return s.current.Load()
+}
+
+// Reload re-reads the store's config file, validates it, and swaps it in
+// only on success. On failure the previous config remains active and an
+// error describing why is returned. On success it returns a slice of
+// human-readable lines describing what changed.
+func (s *Store) Reload() ([]string, error) {
+	next, err := load(s.path, s.format)
+	if err != nil {
+		return nil, fmt.Errorf("reload: %w", err)
+	}
+	if err := Validate(next); err != nil {
+		return nil, fmt.Errorf("reload: invalid config, keeping previous: %w", err)
+	}
+
+	prev := s.current.Swap(next)
+	return Diff(prev, next), nil
+}
+
+// Validate checks invariants that span multiple config sections and
+// can't be expressed as zero-value struct tags.
+func Validate(cfg *ServerConfig) error {
+	if len(cfg.Route) > 0 && (cfg.Auth.CookieSecret == "" || cfg.Auth.CSRFSecret == "") {
+		return fmt.Errorf("Auth.CookieSecret and Auth.CSRFSecret must be set while [Route] sections are defined")
+	}
+	return nil
+}
+
+func load(path, format string) (*ServerConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening config file: %w", err)
+	}
+	defer f.Close()
+	return LoadConfig(f, format)
+}
@@ -0,0 +1,33 @@
+package httpauth
+
+import "net/http"
+
+// LoginHandler authenticates a username/password form submission, and on
+// success issues the session and CSRF cookies.
+func (a *Authenticator) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	username := r.FormValue("username")
+	roles, ok := a.CheckPassword(username, r.FormValue("password"))
+	if !ok {
+		http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		return
+	}
+
+	if err := a.IssueSession(w, username, roles); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	a.IssueCSRFCookie(w, username)
+	w.WriteHeader(http.StatusOK)
+}
+
+// LogoutHandler clears the caller's session and CSRF cookies.
+func (a *Authenticator) LogoutHandler(w http.ResponseWriter, r *http.Request) {
+	a.ClearSession(w)
+	http.SetCookie(w, &http.Cookie{Name: csrfCookieName, Value: "", Path: "/", MaxAge: -1})
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,64 @@
+package httpauth
+
+import (
+	"net/http"
+	"time"
+)
+
+const sessionCookieName = "restrpc_session"
+
+// session is the payload stored, signed and encrypted, inside the
+// session cookie.
+type session struct {
+	Username string
+	Roles    []string
+	Expires  time.Time
+}
+
+// IssueSession sets a signed session cookie for username/roles on w,
+// valid for the configured SessionTTL.
+func (a *Authenticator) IssueSession(w http.ResponseWriter, username string, roles []string) error {
+	sess := session{Username: username, Roles: roles, Expires: time.Now().Add(a.cfg.SessionTTL)}
+	encoded, err := a.cookie.Encode(sessionCookieName, sess)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    encoded,
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  sess.Expires,
+	})
+	return nil
+}
+
+// ClearSession removes the session cookie, used by logout.
+func (a *Authenticator) ClearSession(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		MaxAge:   -1,
+	})
+}
+
+// sessionFromRequest decodes and validates the session cookie on r, if
+// present and not expired.
+func (a *Authenticator) sessionFromRequest(r *http.Request) (*session, bool) {
+	c, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return nil, false
+	}
+
+	var sess session
+	if err := a.cookie.Decode(sessionCookieName, c.Value, &sess); err != nil {
+		return nil, false
+	}
+	if time.Now().After(sess.Expires) {
+		return nil, false
+	}
+	return &sess, true
+}
@@ -0,0 +1,22 @@
+// Package plugin implements the pluggable script executor subsystem.
+// Executors are ordinary Go binaries launched as subprocesses and spoken
+// to over hashicorp/go-plugin, so operators can add script-handling
+// logic without rebuilding or redeploying the server itself.
+package plugin
+
+import (
+	"context"
+
+	"github.com/bountybounter/RestRPC/pkg/rpc"
+)
+
+// Executor is implemented by anything capable of running scripts, whether
+// that's an external plugin process or the built-in local fallback.
+type Executor interface {
+	// CanHandle reports whether this executor is willing to run the
+	// named script.
+	CanHandle(name string) bool
+
+	// Execute runs the script described by req and returns its result.
+	Execute(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error)
+}
@@ -0,0 +1,32 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/bountybounter/RestRPC/pkg/rpc"
+)
+
+// LocalFunc is the signature of the in-process script runner that shipped
+// before the plugin subsystem existed.
+type LocalFunc func(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error)
+
+// localExecutor preserves current behavior for any script that no
+// registered plugin claims: it just calls back into the server's own
+// script runner.
+type localExecutor struct {
+	run LocalFunc
+}
+
+// NewLocalExecutor wraps run as an Executor that claims every script name,
+// so it's safe to register as the last entry in a Manager's executor list.
+func NewLocalExecutor(run LocalFunc) Executor {
+	return &localExecutor{run: run}
+}
+
+func (localExecutor) CanHandle(string) bool {
+	return true
+}
+
+func (e *localExecutor) Execute(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+	return e.run(ctx, req)
+}
@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+
+	goplugin "github.com/hashicorp/go-plugin"
+
+	"github.com/bountybounter/RestRPC/pkg/rpc"
+)
+
+// Config describes one `[Plugin "name"]` section from the server config.
+type Config struct {
+	Path      string
+	Protocol  string
+	Handshake string
+}
+
+type registration struct {
+	name     string
+	executor Executor
+	client   *goplugin.Client
+}
+
+// Manager launches configured plugin subprocesses, health-checks them, and
+// routes incoming script names to the first executor willing to handle
+// them. A Manager with no launched plugins still works: callers are
+// expected to Register a local fallback executor last.
+type Manager struct {
+	registrations []registration
+}
+
+// NewManager returns an empty Manager.
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+// Launch starts name's plugin subprocess per cfg, health-checks the
+// connection, and adds it to the routing list.
+func (m *Manager) Launch(name string, cfg Config) error {
+	handshake := Handshake
+	if cfg.Handshake != "" {
+		handshake.MagicCookieValue = cfg.Handshake
+	}
+
+	protocols := []goplugin.Protocol{goplugin.ProtocolGRPC}
+	if strings.EqualFold(cfg.Protocol, "netrpc") {
+		protocols = []goplugin.Protocol{goplugin.ProtocolNetRPC}
+	}
+
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig:  handshake,
+		Plugins:          goplugin.PluginSet{"executor": &ExecutorPlugin{}},
+		Cmd:              exec.Command(cfg.Path),
+		AllowedProtocols: protocols,
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("plugin %q: %w", name, err)
+	}
+
+	if err := rpcClient.Ping(); err != nil {
+		client.Kill()
+		return fmt.Errorf("plugin %q: health check: %w", name, err)
+	}
+
+	raw, err := rpcClient.Dispense("executor")
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("plugin %q: dispense: %w", name, err)
+	}
+
+	executor, ok := raw.(Executor)
+	if !ok {
+		client.Kill()
+		return fmt.Errorf("plugin %q: does not implement Executor", name)
+	}
+
+	if !client.Exited() {
+		log.Printf("Loaded plugin %q from %s (%s)", name, cfg.Path, cfg.Protocol)
+	}
+
+	m.registrations = append(m.registrations, registration{name: name, executor: executor, client: client})
+	return nil
+}
+
+// Register adds an in-process executor, such as the local fallback, to the
+// end of the routing list.
+func (m *Manager) Register(name string, executor Executor) {
+	m.registrations = append(m.registrations, registration{name: name, executor: executor})
+}
+
+// Route finds the first registered executor willing to handle req and runs
+// it.
+func (m *Manager) Route(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+	for _, r := range m.registrations {
+		if r.executor.CanHandle(req.GetName()) {
+			return r.executor.Execute(ctx, req)
+		}
+	}
+	return nil, fmt.Errorf("no executor registered for script %q", req.GetName())
+}
+
+// Shutdown kills every launched plugin subprocess. Executors registered via
+// Register (in-process ones) are left alone.
+func (m *Manager) Shutdown() {
+	for _, r := range m.registrations {
+		if r.client != nil {
+			r.client.Kill()
+		}
+	}
+}
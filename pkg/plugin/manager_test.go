@@ -0,0 +1,47 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bountybounter/RestRPC/pkg/rpc"
+)
+
+// fakeExecutor claims only the configured name.
+type fakeExecutor struct {
+	name   string
+	output string
+}
+
+func (e *fakeExecutor) CanHandle(name string) bool { return name == e.name }
+
+func (e *fakeExecutor) Execute(context.Context, *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+	return &rpc.ScriptResponse{Output: e.output}, nil
+}
+
+// TestManagerRoute_PluginBeforeLocal verifies that a configured plugin
+// claims a script name ahead of the local fallback, even though local
+// (registered last) is willing to handle anything.
+func TestManagerRoute_PluginBeforeLocal(t *testing.T) {
+	m := NewManager()
+	m.Register("greet", &fakeExecutor{name: "greet", output: "plugin"})
+	m.Register("local", NewLocalExecutor(func(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+		return &rpc.ScriptResponse{Output: "local"}, nil
+	}))
+
+	resp, err := m.Route(context.Background(), &rpc.ScriptRequest{Name: "greet"})
+	if err != nil {
+		t.Fatalf("Route: %v", err)
+	}
+	if resp.GetOutput() != "plugin" {
+		t.Errorf("Route(%q) = %q, want %q", "greet", resp.GetOutput(), "plugin")
+	}
+
+	resp, err = m.Route(context.Background(), &rpc.ScriptRequest{Name: "anything-else"})
+	if err != nil {
+		t.Fatalf("Route: %v", err)
+	}
+	if resp.GetOutput() != "local" {
+		t.Errorf("Route(%q) = %q, want %q", "anything-else", resp.GetOutput(), "local")
+	}
+}
@@ -0,0 +1,68 @@
+package plugin
+
+import (
+	"context"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	"github.com/bountybounter/RestRPC/pkg/plugin/proto"
+	"github.com/bountybounter/RestRPC/pkg/rpc"
+)
+
+// Handshake is shared between the server and every Executor plugin so
+// mismatched builds fail fast instead of producing confusing RPC errors.
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "RESTRPC_EXECUTOR_PLUGIN",
+	MagicCookieValue: "restrpc",
+}
+
+// ExecutorPlugin adapts the Executor interface to go-plugin's gRPC plugin
+// contract so executors can be implemented as separate binaries.
+type ExecutorPlugin struct {
+	goplugin.NetRPCUnsupportedPlugin
+	Impl Executor
+}
+
+func (p *ExecutorPlugin) GRPCServer(_ *goplugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterExecutorServer(s, &executorGRPCServer{impl: p.Impl})
+	return nil
+}
+
+func (p *ExecutorPlugin) GRPCClient(_ context.Context, _ *goplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &executorGRPCClient{client: proto.NewExecutorClient(c)}, nil
+}
+
+// executorGRPCServer runs in the plugin subprocess and forwards calls to
+// the operator-supplied Executor implementation.
+type executorGRPCServer struct {
+	proto.UnimplementedExecutorServer
+	impl Executor
+}
+
+func (s *executorGRPCServer) CanHandle(_ context.Context, req *proto.CanHandleRequest) (*proto.CanHandleResponse, error) {
+	return &proto.CanHandleResponse{Ok: s.impl.CanHandle(req.GetName())}, nil
+}
+
+func (s *executorGRPCServer) Execute(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+	return s.impl.Execute(ctx, req)
+}
+
+// executorGRPCClient runs in the host process and implements Executor by
+// calling out to the plugin subprocess over gRPC.
+type executorGRPCClient struct {
+	client proto.ExecutorClient
+}
+
+func (c *executorGRPCClient) CanHandle(name string) bool {
+	resp, err := c.client.CanHandle(context.Background(), &proto.CanHandleRequest{Name: name})
+	if err != nil {
+		return false
+	}
+	return resp.GetOk()
+}
+
+func (c *executorGRPCClient) Execute(ctx context.Context, req *rpc.ScriptRequest) (*rpc.ScriptResponse, error) {
+	return c.client.Execute(ctx, req)
+}